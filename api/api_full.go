@@ -145,6 +145,13 @@ type FullNode interface {
 	// ChainGetMessagesInTipset returns message stores in current tipset
 	ChainGetMessagesInTipset(ctx context.Context, tsk types.TipSetKey) ([]Message, error) //perm:read
 
+	// ChainGetGasStats returns gas usage and fee statistics for every non-null
+	// tipset with a height in the inclusive range [from, to] on the chain
+	// ending at the specified tipset. Statistics are read from the receipts
+	// committed by the following tipset, so the range must end below the
+	// height of the specified tipset. Results are ordered by ascending height.
+	ChainGetGasStats(ctx context.Context, from, to abi.ChainEpoch, tsk types.TipSetKey) ([]EpochGasStats, error) //perm:read
+
 	// ChainGetTipSetByHeight looks back for a tipset at the specified epoch.
 	// If there are no blocks at the specified epoch, a tipset at an earlier epoch
 	// will be returned.
@@ -1129,6 +1136,25 @@ type MsgGasCost struct {
 	TotalCost          abi.TokenAmount
 }
 
+// EpochGasStats summarises gas consumption and fees paid by the messages
+// included in a single tipset.
+type EpochGasStats struct {
+	Height   abi.ChainEpoch
+	TipSet   types.TipSetKey
+	Blocks   int
+	Messages int
+
+	BaseFee       abi.TokenAmount // base fee the tipset's messages were executed at
+	GasLimit      int64           // sum of the gas limits of the executed messages
+	GasUsed       int64
+	BlockGasLimit int64 // gas capacity of the tipset, the block gas limit times the number of blocks
+
+	BaseFeeBurn        abi.TokenAmount
+	OverEstimationBurn abi.TokenAmount
+	MinerTip           abi.TokenAmount
+	TotalFees          abi.TokenAmount // BaseFeeBurn + OverEstimationBurn + MinerTip
+}
+
 type BlockMessages struct {
 	BlsMessages   []*types.Message       // BlsMessages [x].cid = Cids[x]
 	SecpkMessages []*types.SignedMessage // SecpkMessages [y].cid = Cids[BlsMessages.length + y]
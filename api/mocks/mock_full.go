@@ -230,6 +230,21 @@ func (mr *MockFullNodeMockRecorder) ChainGetFinalizedTipSet(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainGetFinalizedTipSet", reflect.TypeOf((*MockFullNode)(nil).ChainGetFinalizedTipSet), arg0)
 }
 
+// ChainGetGasStats mocks base method.
+func (m *MockFullNode) ChainGetGasStats(arg0 context.Context, arg1, arg2 abi.ChainEpoch, arg3 types.TipSetKey) ([]api.EpochGasStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainGetGasStats", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]api.EpochGasStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChainGetGasStats indicates an expected call of ChainGetGasStats.
+func (mr *MockFullNodeMockRecorder) ChainGetGasStats(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainGetGasStats", reflect.TypeOf((*MockFullNode)(nil).ChainGetGasStats), arg0, arg1, arg2, arg3)
+}
+
 // ChainGetGenesis mocks base method.
 func (m *MockFullNode) ChainGetGenesis(arg0 context.Context) (*types.TipSet, error) {
 	m.ctrl.T.Helper()
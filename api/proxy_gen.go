@@ -132,6 +132,8 @@ type FullNodeMethods struct {
 
 	ChainGetFinalizedTipSet func(p0 context.Context) (*types.TipSet, error) `perm:"read"`
 
+	ChainGetGasStats func(p0 context.Context, p1 abi.ChainEpoch, p2 abi.ChainEpoch, p3 types.TipSetKey) ([]EpochGasStats, error) `perm:"read"`
+
 	ChainGetGenesis func(p0 context.Context) (*types.TipSet, error) `perm:"read"`
 
 	ChainGetMessage func(p0 context.Context, p1 cid.Cid) (*types.Message, error) `perm:"read"`
@@ -1464,6 +1466,17 @@ func (s *FullNodeStub) ChainGetFinalizedTipSet(p0 context.Context) (*types.TipSe
 	return nil, ErrNotSupported
 }
 
+func (s *FullNodeStruct) ChainGetGasStats(p0 context.Context, p1 abi.ChainEpoch, p2 abi.ChainEpoch, p3 types.TipSetKey) ([]EpochGasStats, error) {
+	if s.Internal.ChainGetGasStats == nil {
+		return *new([]EpochGasStats), ErrNotSupported
+	}
+	return s.Internal.ChainGetGasStats(p0, p1, p2, p3)
+}
+
+func (s *FullNodeStub) ChainGetGasStats(p0 context.Context, p1 abi.ChainEpoch, p2 abi.ChainEpoch, p3 types.TipSetKey) ([]EpochGasStats, error) {
+	return *new([]EpochGasStats), ErrNotSupported
+}
+
 func (s *FullNodeStruct) ChainGetGenesis(p0 context.Context) (*types.TipSet, error) {
 	if s.Internal.ChainGetGenesis == nil {
 		return nil, ErrNotSupported
@@ -0,0 +1,78 @@
+package itests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/filecoin-project/go-state-types/big"
+
+	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/build/buildconstants"
+	"github.com/filecoin-project/lotus/chain/types"
+	"github.com/filecoin-project/lotus/itests/kit"
+)
+
+func TestChainGetGasStats(t *testing.T) {
+	ctx := context.Background()
+
+	kit.QuietMiningLogs()
+
+	client, _, ens := kit.EnsembleMinimal(t, kit.MockProofs())
+	ens.InterconnectAll().BeginMining(10 * time.Millisecond)
+
+	addr, err := client.WalletNew(ctx, types.KTBLS)
+	require.NoError(t, err)
+
+	sm, err := client.MpoolPushMessage(ctx, &types.Message{
+		From:  client.DefaultKey.Address,
+		To:    addr,
+		Value: big.NewInt(1000),
+	}, nil)
+	require.NoError(t, err)
+
+	lookup, err := client.StateWaitMsg(ctx, sm.Cid(), 1, api.LookbackNoLimit, true)
+	require.NoError(t, err)
+
+	execTs, err := client.ChainGetTipSet(ctx, lookup.TipSet)
+	require.NoError(t, err)
+	inclTs, err := client.ChainGetTipSet(ctx, execTs.Parents())
+	require.NoError(t, err)
+
+	replay, err := client.StateReplay(ctx, types.EmptyTSK, sm.Cid())
+	require.NoError(t, err)
+
+	stats, err := client.ChainGetGasStats(ctx, inclTs.Height(), inclTs.Height(), execTs.Key())
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+
+	s := stats[0]
+	require.Equal(t, inclTs.Height(), s.Height)
+	require.Equal(t, inclTs.Key(), s.TipSet)
+	require.Equal(t, len(inclTs.Blocks()), s.Blocks)
+	require.True(t, inclTs.Blocks()[0].ParentBaseFee.Equals(s.BaseFee))
+	require.Equal(t, buildconstants.BlockGasLimit*int64(len(inclTs.Blocks())), s.BlockGasLimit)
+
+	// other messages may have landed in the same tipset
+	require.GreaterOrEqual(t, s.Messages, 1)
+	require.GreaterOrEqual(t, s.GasLimit, sm.Message.GasLimit)
+	require.GreaterOrEqual(t, s.GasUsed, lookup.Receipt.GasUsed)
+	require.True(t, s.TotalFees.Equals(big.Sum(s.BaseFeeBurn, s.OverEstimationBurn, s.MinerTip)))
+	msgFees := big.Sum(replay.GasCost.BaseFeeBurn, replay.GasCost.OverEstimationBurn, replay.GasCost.MinerTip)
+	require.True(t, s.TotalFees.GreaterThanEqual(msgFees))
+
+	// the tipset the range is anchored at has no receipts yet
+	_, err = client.ChainGetGasStats(ctx, execTs.Height(), execTs.Height(), execTs.Key())
+	require.Error(t, err)
+
+	// stats over a wider range are returned in ascending order
+	stats, err = client.ChainGetGasStats(ctx, 1, execTs.Height()-1, execTs.Key())
+	require.NoError(t, err)
+	require.NotEmpty(t, stats)
+	for i := 1; i < len(stats); i++ {
+		require.Less(t, stats[i-1].Height, stats[i].Height)
+	}
+	require.Equal(t, inclTs.Height(), stats[len(stats)-1].Height)
+}
@@ -10,6 +10,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,10 +33,12 @@ import (
 	amt4 "github.com/filecoin-project/go-amt-ipld/v4"
 	"github.com/filecoin-project/go-f3/certs"
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/go-state-types/big"
 	"github.com/filecoin-project/specs-actors/actors/util/adt"
 
 	"github.com/filecoin-project/lotus/api"
 	"github.com/filecoin-project/lotus/blockstore"
+	"github.com/filecoin-project/lotus/build/buildconstants"
 	"github.com/filecoin-project/lotus/chain/actors/policy"
 	"github.com/filecoin-project/lotus/chain/lf3"
 	"github.com/filecoin-project/lotus/chain/stmgr"
@@ -239,6 +242,102 @@ func (a *ChainAPI) ChainGetMessagesInTipset(ctx context.Context, tsk types.TipSe
 	return out, nil
 }
 
+// maxGasStatsRange is the maximum number of epochs a single ChainGetGasStats
+// call can cover (one day worth of epochs).
+const maxGasStatsRange = 2880
+
+func (a *ChainAPI) ChainGetGasStats(ctx context.Context, from, to abi.ChainEpoch, tsk types.TipSetKey) ([]api.EpochGasStats, error) {
+	if from < 0 || to < from {
+		return nil, xerrors.Errorf("invalid epoch range [%d, %d]", from, to)
+	}
+	if to-from >= maxGasStatsRange {
+		return nil, xerrors.Errorf("epoch range [%d, %d] too large, at most %d epochs can be requested", from, to, maxGasStatsRange)
+	}
+
+	head, err := a.Chain.GetTipSetFromKey(ctx, tsk)
+	if err != nil {
+		return nil, xerrors.Errorf("loading tipset %s: %w", tsk, err)
+	}
+	if to >= head.Height() {
+		return nil, xerrors.Errorf("range end %d must be below the height of tipset %s (%d)", to, head.Key(), head.Height())
+	}
+
+	// Receipts for the messages in a tipset are committed by the first non-null
+	// tipset above it, so start from the child of the last requested epoch and
+	// walk back through the parents.
+	child, err := a.Chain.GetTipsetByHeight(ctx, to+1, head, false)
+	if err != nil {
+		return nil, xerrors.Errorf("loading tipset at height %d: %w", to+1, err)
+	}
+
+	var out []api.EpochGasStats
+	for child.Height() > 0 {
+		ts, err := a.Chain.LoadTipSet(ctx, child.Parents())
+		if err != nil {
+			return nil, xerrors.Errorf("loading parent tipset %s: %w", child.Parents(), err)
+		}
+		if ts.Height() < from {
+			break
+		}
+
+		stats, err := a.gasStats(ctx, ts, child)
+		if err != nil {
+			return nil, xerrors.Errorf("computing gas stats at height %d: %w", ts.Height(), err)
+		}
+		out = append(out, stats)
+		child = ts
+	}
+
+	// collected newest to oldest
+	slices.Reverse(out)
+	return out, nil
+}
+
+// gasStats computes the gas statistics for the messages in ts using the
+// receipts committed by child.
+func (a *ChainAPI) gasStats(ctx context.Context, ts, child *types.TipSet) (api.EpochGasStats, error) {
+	msgs, err := a.Chain.MessagesForTipset(ctx, ts)
+	if err != nil {
+		return api.EpochGasStats{}, xerrors.Errorf("loading messages: %w", err)
+	}
+
+	rcpts, err := a.Chain.ReadReceipts(ctx, child.ParentMessageReceipts())
+	if err != nil {
+		return api.EpochGasStats{}, xerrors.Errorf("loading receipts: %w", err)
+	}
+
+	if len(msgs) != len(rcpts) {
+		return api.EpochGasStats{}, xerrors.Errorf("got %d messages but %d receipts", len(msgs), len(rcpts))
+	}
+
+	baseFee := ts.Blocks()[0].ParentBaseFee
+	stats := api.EpochGasStats{
+		Height:             ts.Height(),
+		TipSet:             ts.Key(),
+		Blocks:             len(ts.Blocks()),
+		Messages:           len(msgs),
+		BaseFee:            baseFee,
+		BlockGasLimit:      buildconstants.BlockGasLimit * int64(len(ts.Blocks())),
+		BaseFeeBurn:        big.Zero(),
+		OverEstimationBurn: big.Zero(),
+		MinerTip:           big.Zero(),
+	}
+
+	for i, cm := range msgs {
+		msg := cm.VMMessage()
+		gasOutputs := vm.ComputeGasOutputs(rcpts[i].GasUsed, msg.GasLimit, baseFee, msg.GasFeeCap, msg.GasPremium, true)
+
+		stats.GasLimit += msg.GasLimit
+		stats.GasUsed += rcpts[i].GasUsed
+		stats.BaseFeeBurn = big.Add(stats.BaseFeeBurn, gasOutputs.BaseFeeBurn)
+		stats.OverEstimationBurn = big.Add(stats.OverEstimationBurn, gasOutputs.OverEstimationBurn)
+		stats.MinerTip = big.Add(stats.MinerTip, gasOutputs.MinerTip)
+	}
+	stats.TotalFees = big.Sum(stats.BaseFeeBurn, stats.OverEstimationBurn, stats.MinerTip)
+
+	return stats, nil
+}
+
 func (m *ChainModule) ChainGetTipSetByHeight(ctx context.Context, h abi.ChainEpoch, tsk types.TipSetKey) (*types.TipSet, error) {
 	ts, err := m.Chain.GetTipSetFromKey(ctx, tsk)
 	if err != nil {